@@ -0,0 +1,177 @@
+// Deprecated: aws-sdk-go is deprecated. Use aws-sdk-go-v2.
+// See https://aws.amazon.com/blogs/developer/announcing-end-of-support-for-aws-sdk-for-go-v1-on-july-31-2025/.
+package bearer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/internal/sync/singleflight"
+)
+
+// TokenCacheOptions provides configuration options for TokenCache.
+type TokenCacheOptions struct {
+	// WindowTime will be subtracted from the Token's Expires time to
+	// determine if a cached Token should be treated as expired and
+	// refreshed before it is actually expired. This is helpful to avoid
+	// requests failing due to using a Token that expires in-flight.
+	WindowTime time.Duration
+}
+
+// Option is a functional option for configuring a TokenCache.
+type Option func(*TokenCacheOptions)
+
+// WithWindowTime sets the TokenCacheOptions WindowTime value. See
+// TokenCacheOptions.WindowTime for more details.
+func WithWindowTime(window time.Duration) Option {
+	return func(o *TokenCacheOptions) {
+		o.WindowTime = window
+	}
+}
+
+// TokenCache provides a concurrency safe, caching decorator around a
+// TokenProvider. The first call to RetrieveBearerToken always calls the
+// wrapped TokenProvider to retrieve a Token. Subsequent calls return the
+// cached Token until it is Expired, at which point the wrapped
+// TokenProvider is called again to refresh it.
+//
+// Concurrent refreshes triggered by racing callers are coalesced into a
+// single call to the wrapped TokenProvider via a singleflight.Group, whose
+// result is shared with all callers waiting on that refresh. A caller's
+// context being canceled only aborts that caller's wait; it does not cancel
+// the shared retrieval.
+//
+// TokenCache is safe to use across multiple goroutines.
+type TokenCache struct {
+	options  TokenCacheOptions
+	provider TokenProvider
+
+	sf singleflight.Group
+
+	m     sync.RWMutex
+	token Token
+}
+
+// NewTokenCache returns a TokenCache wrapping the provided TokenProvider.
+// Use the functional options to configure the returned TokenCache.
+func NewTokenCache(provider TokenProvider, optFns ...Option) *TokenCache {
+	var o TokenCacheOptions
+	for _, fn := range optFns {
+		fn(&o)
+	}
+
+	return &TokenCache{
+		options:  o,
+		provider: provider,
+	}
+}
+
+// RetrieveBearerToken returns the cached Token if it has not Expired.
+// Otherwise it calls the wrapped TokenProvider's RetrieveBearerToken to
+// refresh the cached Token, coalescing concurrent refreshes into a single
+// call. Will return early if the passed in context is canceled, without
+// affecting other callers waiting on the same refresh.
+func (p *TokenCache) RetrieveBearerToken(ctx aws.Context) (Token, error) {
+	select {
+	case curToken, ok := <-p.asyncIsExpired():
+		if ok {
+			return curToken, nil
+		}
+	case <-ctx.Done():
+		return Token{}, awserr.New("RequestCanceled",
+			"request context canceled", ctx.Err())
+	}
+
+	resCh := p.sf.DoChan("", func() (interface{}, error) {
+		return p.singleRetrieve(&suppressedContext{ctx})
+	})
+	select {
+	case res := <-resCh:
+		return res.Val.(Token), res.Err
+	case <-ctx.Done():
+		return Token{}, awserr.New("RequestCanceled",
+			"request context canceled", ctx.Err())
+	}
+}
+
+func (p *TokenCache) singleRetrieve(ctx aws.Context) (interface{}, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if curToken := p.token; !p.isExpiredLocked(curToken) {
+		return curToken, nil
+	}
+
+	token, err := p.provider.RetrieveBearerToken(ctx)
+	if err == nil {
+		p.token = token
+	}
+
+	return token, err
+}
+
+// Expire expires the cached Token, forcing the next call to
+// RetrieveBearerToken to refresh it from the wrapped TokenProvider.
+func (p *TokenCache) Expire() {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	p.token = Token{}
+}
+
+// IsExpired returns if the cached Token is expired and needs to be
+// refreshed.
+func (p *TokenCache) IsExpired() bool {
+	p.m.RLock()
+	defer p.m.RUnlock()
+
+	return p.isExpiredLocked(p.token)
+}
+
+// ExpiresAt returns the expiration time of the cached Token.
+func (p *TokenCache) ExpiresAt() time.Time {
+	p.m.RLock()
+	defer p.m.RUnlock()
+
+	return p.token.Expires
+}
+
+// asyncIsExpired returns a channel of Token. If the channel is closed the
+// Token is expired and no value is sent.
+func (p *TokenCache) asyncIsExpired() <-chan Token {
+	ch := make(chan Token, 1)
+	go func() {
+		p.m.RLock()
+		defer p.m.RUnlock()
+
+		if curToken := p.token; !p.isExpiredLocked(curToken) {
+			ch <- curToken
+		}
+
+		close(ch)
+	}()
+
+	return ch
+}
+
+func (p *TokenCache) isExpiredLocked(token Token) bool {
+	return token == (Token{}) || token.Expired(time.Now().Add(p.options.WindowTime))
+}
+
+type suppressedContext struct {
+	aws.Context
+}
+
+func (s *suppressedContext) Deadline() (deadline time.Time, ok bool) {
+	return time.Time{}, false
+}
+
+func (s *suppressedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (s *suppressedContext) Err() error {
+	return nil
+}
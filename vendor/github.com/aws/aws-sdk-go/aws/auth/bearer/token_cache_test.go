@@ -0,0 +1,127 @@
+// Deprecated: aws-sdk-go is deprecated. Use aws-sdk-go-v2.
+// See https://aws.amazon.com/blogs/developer/announcing-end-of-support-for-aws-sdk-for-go-v1-on-july-31-2025/.
+package bearer
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// mockTokenProvider is a TokenProvider whose RetrieveBearerToken is
+// counted, and can be swapped out via setRetrieveFn to simulate a slow or
+// failing refresh.
+type mockTokenProvider struct {
+	token      Token
+	retrieveFn func() (Token, error)
+
+	m     sync.Mutex
+	calls int
+}
+
+func (m *mockTokenProvider) RetrieveBearerToken(ctx aws.Context) (Token, error) {
+	m.m.Lock()
+	m.calls++
+	fn := m.retrieveFn
+	m.m.Unlock()
+
+	if fn != nil {
+		return fn()
+	}
+	return m.token, nil
+}
+
+func (m *mockTokenProvider) setRetrieveFn(fn func() (Token, error)) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.retrieveFn = fn
+}
+
+func (m *mockTokenProvider) callCount() int {
+	m.m.Lock()
+	defer m.m.Unlock()
+	return m.calls
+}
+
+func TestTokenCache_ConcurrentRefreshesAreCoalesced(t *testing.T) {
+	block := make(chan struct{})
+	provider := &mockTokenProvider{}
+	provider.setRetrieveFn(func() (Token, error) {
+		<-block
+		return Token{Value: "token", CanExpire: true, Expires: time.Now().Add(time.Hour)}, nil
+	})
+
+	c := NewTokenCache(provider)
+
+	const n = 50
+	var wg sync.WaitGroup
+	results := make([]Token, n)
+	errs := make([]error, n)
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		i := i
+		go func() {
+			defer wg.Done()
+			tok, err := c.RetrieveBearerToken(aws.BackgroundContext())
+			results[i] = tok
+			errs[i] = err
+		}()
+	}
+
+	// Give every goroutine a chance to land on the shared, in-flight
+	// refresh before unblocking it.
+	time.Sleep(20 * time.Millisecond)
+	close(block)
+	wg.Wait()
+
+	if got := provider.callCount(); got != 1 {
+		t.Fatalf("expected exactly 1 call to the wrapped TokenProvider for %d goroutines racing the same refresh, got %d", n, got)
+	}
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("goroutine %d: RetrieveBearerToken failed: %v", i, err)
+		}
+		if results[i].Value != "token" {
+			t.Fatalf("goroutine %d: unexpected token %+v", i, results[i])
+		}
+	}
+}
+
+func TestTokenCache_WithWindowTime_RefreshesBeforeExpires(t *testing.T) {
+	provider := &mockTokenProvider{
+		token: Token{Value: "first", CanExpire: true, Expires: time.Now().Add(4 * time.Minute)},
+	}
+
+	c := NewTokenCache(provider, WithWindowTime(5*time.Minute))
+
+	tok, err := c.RetrieveBearerToken(aws.BackgroundContext())
+	if err != nil {
+		t.Fatalf("RetrieveBearerToken failed: %v", err)
+	}
+	if tok.Value != "first" {
+		t.Fatalf("unexpected token: %+v", tok)
+	}
+	if got := provider.callCount(); got != 1 {
+		t.Fatalf("expected 1 call after priming, got %d", got)
+	}
+
+	// Expires is only 4 minutes out, inside the configured 5 minute
+	// window, so the cached Token must already be treated as expired and
+	// refreshed rather than reused.
+	provider.setRetrieveFn(func() (Token, error) {
+		return Token{Value: "second", CanExpire: true, Expires: time.Now().Add(time.Hour)}, nil
+	})
+
+	tok, err = c.RetrieveBearerToken(aws.BackgroundContext())
+	if err != nil {
+		t.Fatalf("RetrieveBearerToken failed: %v", err)
+	}
+	if tok.Value != "second" {
+		t.Fatalf("expected WithWindowTime to trigger an early refresh, got %+v", tok)
+	}
+	if got := provider.callCount(); got != 2 {
+		t.Fatalf("expected 2 total calls, got %d", got)
+	}
+}
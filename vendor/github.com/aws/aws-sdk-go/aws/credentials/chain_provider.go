@@ -0,0 +1,167 @@
+// Deprecated: aws-sdk-go is deprecated. Use aws-sdk-go-v2.
+// See https://aws.amazon.com/blogs/developer/announcing-end-of-support-for-aws-sdk-for-go-v1-on-july-31-2025/.
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// ErrNoValidProvidersFoundInChain is returned when all of the Providers in
+// a ChainProvider fail to retrieve credentials, and VerboseErrors is
+// false.
+var ErrNoValidProvidersFoundInChain = awserr.New("NoCredentialProviders",
+	`no valid providers in chain. Deprecated.
+	For verbose messaging see aws.Config.CredentialsChainVerboseErrors`,
+	nil)
+
+// A ChainProvider will search for a provider which returns credentials
+// and cache that provider until Retrieve is called again.
+//
+// The ChainProvider provides a way of chaining multiple providers together
+// which will pick the first available using priority order of the
+// Providers in the list.
+//
+// If none of the Providers retrieve valid credentials, ChainProvider's
+// Retrieve() will return ErrNoValidProvidersFoundInChain, or, if
+// VerboseErrors is true, an error listing every Provider's error.
+//
+//	creds := credentials.NewChainCredentials(
+//	    []credentials.Provider{
+//	        &credentials.EnvProvider{},
+//	        &credentials.SharedCredentialsProvider{},
+//	    })
+//
+//	// Usage of ChainCredentials with aws.Config
+//	svc := ec2.New(session.Must(session.NewSession(&aws.Config{
+//	    Credentials: creds,
+//	})))
+type ChainProvider struct {
+	// Providers is the list of credentials Providers that will be
+	// consulted in order for a valid set of credentials.
+	Providers []Provider
+
+	// VerboseErrors, if true, causes Retrieve to return an error
+	// aggregating the ProviderName and Retrieve error of every Provider
+	// in the chain. Matches aws.Config.CredentialsChainVerboseErrors.
+	VerboseErrors bool
+
+	// RetrieveTimeout, if non-zero, bounds how long any single Provider
+	// implementing ProviderWithContext is given to Retrieve credentials
+	// before ChainProvider moves on to the next Provider in the chain.
+	// This keeps a hung provider, such as an unreachable IMDS endpoint,
+	// from stalling the entire chain. Providers that only implement
+	// Provider (not ProviderWithContext) are not subject to this timeout.
+	RetrieveTimeout time.Duration
+
+	curr Provider
+}
+
+// NewChainCredentials returns a pointer to a new Credentials object
+// wrapping a chain of providers.
+func NewChainCredentials(providers []Provider) *Credentials {
+	return NewCredentials(&ChainProvider{
+		Providers: append([]Provider{}, providers...),
+	})
+}
+
+// Retrieve returns the credentials value or error if no provider returned
+// without error.
+//
+// If a provider is found it will be cached, and IsExpired() will report
+// the cached provider's expired state. If it is expired the
+// IsExpired() call will return true, and ChainProvider.Retrieve() will be
+// called again to find a valid provider.
+func (c *ChainProvider) Retrieve() (Value, error) {
+	return c.RetrieveWithContext(backgroundContext())
+}
+
+// RetrieveWithContext returns the credentials value or error if no
+// provider returned without error. See Retrieve for more details.
+func (c *ChainProvider) RetrieveWithContext(ctx Context) (Value, error) {
+	var errs []string
+
+	for _, p := range c.Providers {
+		creds, err := c.retrieveOne(ctx, p)
+		if err == nil {
+			c.curr = p
+			return creds, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", retrievedProviderName(p, creds), err))
+	}
+	c.curr = nil
+
+	if c.VerboseErrors {
+		return Value{}, awserr.New("NoCredentialProviders",
+			fmt.Sprintf("no valid providers in chain, deferred errors:\n\t%s",
+				strings.Join(errs, "\n\t")), nil)
+	}
+	return Value{}, ErrNoValidProvidersFoundInChain
+}
+
+// retrieveOne calls Retrieve on a single Provider. Providers implementing
+// ProviderWithContext are always called via RetrieveWithContext so ctx
+// cancellation propagates through the whole chain; RetrieveTimeout, if
+// configured, additionally bounds how long any one of those calls may
+// take. Providers that only implement Provider have no context to honor
+// and are called via their plain Retrieve.
+func (c *ChainProvider) retrieveOne(ctx Context, p Provider) (Value, error) {
+	pc, ok := p.(ProviderWithContext)
+	if !ok {
+		return p.Retrieve()
+	}
+
+	if c.RetrieveTimeout <= 0 {
+		return pc.RetrieveWithContext(ctx)
+	}
+
+	tctx, cancel := context.WithTimeout(ctx, c.RetrieveTimeout)
+	defer cancel()
+
+	creds, err := pc.RetrieveWithContext(tctx)
+	if err != nil && tctx.Err() == context.DeadlineExceeded {
+		return Value{}, fmt.Errorf("retrieve timed out after %s", c.RetrieveTimeout)
+	}
+	return creds, err
+}
+
+// IsExpired returns if the current cached provider is expired.
+func (c *ChainProvider) IsExpired() bool {
+	if c.curr != nil {
+		return c.curr.IsExpired()
+	}
+	return true
+}
+
+// NewDefaultChainCredentials returns a Credentials wrapping a
+// ChainProvider pre-populated, in order, with an EnvProvider and a
+// SharedCredentialsProvider. This covers the non-IMDS legs of the
+// env->shared-file->IMDS chain Session assembles by default.
+//
+// IMDS is deliberately NOT included in this constructor: an
+// ec2rolecreds.EC2RoleProvider needs an ec2metadata.Client, and both
+// aws/ec2metadata and aws/client import the root aws package, which
+// imports this package for aws.Config.Credentials. Importing either from here would close that loop into an import cycle
+// (credentials -> ec2metadata/client -> aws -> credentials). This is the
+// same constraint that keeps the real SDK's equivalent default-chain
+// assembly (aws/defaults.CredChain) out of the credentials package too.
+//
+// Callers that want IMDS in the chain should construct the
+// ec2rolecreds.EC2RoleProvider themselves and append it via imdsProviders,
+// which is placed after the env/shared-file providers, preserving the
+// requested ordering:
+//
+//	creds := credentials.NewDefaultChainCredentials(
+//	    &ec2rolecreds.EC2RoleProvider{Client: ec2metadata.New(sess)},
+//	)
+func NewDefaultChainCredentials(imdsProviders ...Provider) *Credentials {
+	providers := append([]Provider{
+		&EnvProvider{},
+		&SharedCredentialsProvider{},
+	}, imdsProviders...)
+	return NewChainCredentials(providers)
+}
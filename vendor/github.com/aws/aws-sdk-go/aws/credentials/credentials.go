@@ -214,6 +214,54 @@ type Credentials struct {
 	m        sync.RWMutex
 	creds    Value
 	provider Provider
+
+	options Options
+}
+
+// Options provides configuration for how a Credentials refreshes the
+// Value it caches.
+type Options struct {
+	// RefreshBeforeExpiry defines how far in advance of the cached Value's
+	// expiration GetWithContext begins proactively refreshing it in the
+	// background rather than blocking the caller on a synchronous
+	// Retrieve. Has no effect unless AsyncRefresh is true and the wrapped
+	// Provider implements Expirer.
+	RefreshBeforeExpiry time.Duration
+
+	// AsyncRefresh enables the background refresh behavior described by
+	// RefreshBeforeExpiry. When false, Credentials only ever refreshes
+	// synchronously once the Provider reports the Value expired, matching
+	// the behavior of Credentials constructed with NewCredentials.
+	AsyncRefresh bool
+
+	// Logger, if set, receives debug and warning level diagnostics about
+	// cache hits, refreshes, and refresh failures. Background refresh
+	// failures are otherwise swallowed so a transient failure does not
+	// discard still-valid cached credentials.
+	Logger Logger
+
+	// OnRetrieve, if set, is called every time the wrapped Provider's
+	// Retrieve is invoked, whether triggered by a cache miss or by a
+	// background refresh, with the duration and error of that call.
+	// Concurrent callers racing on the same refresh only trigger one
+	// Retrieve, and so only one OnRetrieve call.
+	OnRetrieve func(providerName string, dur time.Duration, err error)
+
+	// OnCacheHit, if set, is called whenever GetWithContext is served the
+	// cached Value without invoking Retrieve.
+	OnCacheHit func(providerName string)
+
+	// OnExpire, if set, is called whenever the cached Value is force
+	// expired via Expire.
+	OnExpire func(providerName string)
+}
+
+// Logger is the minimal logging interface Credentials uses to surface
+// diagnostics about its refresh lifecycle. Implementations may wrap
+// aws.Logger, a structured logger, or any other logging facility.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Warnf(format string, args ...interface{})
 }
 
 // NewCredentials returns a pointer to a new Credentials with the provider set.
@@ -224,6 +272,16 @@ func NewCredentials(provider Provider) *Credentials {
 	return c
 }
 
+// NewCredentialsWithOptions returns a pointer to a new Credentials with the
+// provider and Options set. See Options for the behavior the Options
+// control.
+func NewCredentialsWithOptions(provider Provider, options Options) *Credentials {
+	return &Credentials{
+		provider: provider,
+		options:  options,
+	}
+}
+
 // GetWithContext returns the credentials value, or error if the credentials
 // Value failed to be retrieved. Will return early if the passed in context is
 // canceled.
@@ -273,6 +331,7 @@ func (c *Credentials) singleRetrieve(ctx Context) (interface{}, error) {
 		return curCreds, nil
 	}
 
+	start := time.Now()
 	var creds Value
 	var err error
 	if p, ok := c.provider.(ProviderWithContext); ok {
@@ -280,13 +339,35 @@ func (c *Credentials) singleRetrieve(ctx Context) (interface{}, error) {
 	} else {
 		creds, err = c.provider.Retrieve()
 	}
+	dur := time.Since(start)
+
 	if err == nil {
 		c.creds = creds
+		if c.options.Logger != nil {
+			c.options.Logger.Debugf("retrieved credentials from provider %s in %s", creds.ProviderName, dur)
+		}
+	} else if c.options.Logger != nil {
+		c.options.Logger.Warnf("failed to retrieve credentials from provider %T after %s: %v", c.provider, dur, err)
+	}
+
+	if c.options.OnRetrieve != nil {
+		c.options.OnRetrieve(retrievedProviderName(c.provider, creds), dur, err)
 	}
 
 	return creds, err
 }
 
+// retrievedProviderName returns the provider name to report for a
+// Retrieve of provider that returned creds: creds.ProviderName when the
+// Provider set it, on success or on failure, otherwise the Provider's
+// type name.
+func retrievedProviderName(provider Provider, creds Value) string {
+	if len(creds.ProviderName) != 0 {
+		return creds.ProviderName
+	}
+	return fmt.Sprintf("%T", provider)
+}
+
 // Get returns the credentials value, or error if the credentials Value failed
 // to be retrieved.
 //
@@ -309,7 +390,12 @@ func (c *Credentials) Expire() {
 	c.m.Lock()
 	defer c.m.Unlock()
 
+	providerName := c.creds.ProviderName
 	c.creds = Value{}
+
+	if c.options.OnExpire != nil {
+		c.options.OnExpire(providerName)
+	}
 }
 
 // IsExpired returns if the credentials are no longer valid, and need
@@ -330,9 +416,20 @@ func (c *Credentials) asyncIsExpired() <-chan Value {
 	ch := make(chan Value, 1)
 	go func() {
 		c.m.RLock()
-		defer c.m.RUnlock()
-
-		if curCreds := c.creds; !c.isExpiredLocked(curCreds) {
+		curCreds := c.creds
+		expired := c.isExpiredLocked(curCreds)
+		c.m.RUnlock()
+
+		if !expired {
+			if c.options.AsyncRefresh && c.isWithinRefreshWindow() {
+				c.refreshAsync()
+			}
+			if c.options.OnCacheHit != nil {
+				c.options.OnCacheHit(curCreds.ProviderName)
+			}
+			if c.options.Logger != nil {
+				c.options.Logger.Debugf("credentials cache hit for provider %s", curCreds.ProviderName)
+			}
 			ch <- curCreds
 		}
 
@@ -342,6 +439,33 @@ func (c *Credentials) asyncIsExpired() <-chan Value {
 	return ch
 }
 
+// isWithinRefreshWindow returns true if the wrapped Provider implements
+// Expirer and the current time is within Options.RefreshBeforeExpiry of
+// the Provider's reported expiration.
+func (c *Credentials) isWithinRefreshWindow() bool {
+	if c.options.RefreshBeforeExpiry <= 0 {
+		return false
+	}
+	expirer, ok := c.provider.(Expirer)
+	if !ok {
+		return false
+	}
+	return !time.Now().Add(c.options.RefreshBeforeExpiry).Before(expirer.ExpiresAt())
+}
+
+// refreshAsync kicks off a background refresh of the cached Value. It
+// reuses c.sf so a refresh triggered here is coalesced with any concurrent
+// synchronous refresh racing to replace the same expired Value. No user
+// context is propagated to the refresh, so a caller canceling its own
+// context has no effect on it. singleRetrieve itself logs and swallows the
+// error, if any, so a transient failure does not discard the still-valid
+// cached Value.
+func (c *Credentials) refreshAsync() {
+	c.sf.DoChan("", func() (interface{}, error) {
+		return c.singleRetrieve(&suppressedContext{backgroundContext()})
+	})
+}
+
 // isExpiredLocked helper method wrapping the definition of expired credentials.
 func (c *Credentials) isExpiredLocked(creds interface{}) bool {
 	return creds == nil || creds.(Value) == Value{} || c.provider.IsExpired()
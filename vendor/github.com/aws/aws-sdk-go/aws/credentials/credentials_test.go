@@ -0,0 +1,240 @@
+// Deprecated: aws-sdk-go is deprecated. Use aws-sdk-go-v2.
+// See https://aws.amazon.com/blogs/developer/announcing-end-of-support-for-aws-sdk-for-go-v1-on-july-31-2025/.
+package credentials
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// mockExpiryProvider is a Provider that embeds Expiry, the same way the
+// package doc's EC2RoleProvider example does, so IsExpired/ExpiresAt come
+// from SetExpiration and CurrentTime rather than being hand rolled per
+// test. retrieveFn, when set, replaces the default Value returned by
+// RetrieveWithContext, and every call is counted.
+type mockExpiryProvider struct {
+	Expiry
+
+	value      Value
+	retrieveFn func() (Value, error)
+
+	m     sync.Mutex
+	calls int
+}
+
+func (m *mockExpiryProvider) Retrieve() (Value, error) {
+	return m.RetrieveWithContext(backgroundContext())
+}
+
+func (m *mockExpiryProvider) RetrieveWithContext(ctx Context) (Value, error) {
+	m.m.Lock()
+	m.calls++
+	fn := m.retrieveFn
+	m.m.Unlock()
+
+	if fn != nil {
+		return fn()
+	}
+	return m.value, nil
+}
+
+func (m *mockExpiryProvider) setRetrieveFn(fn func() (Value, error)) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.retrieveFn = fn
+}
+
+func (m *mockExpiryProvider) callCount() int {
+	m.m.Lock()
+	defer m.m.Unlock()
+	return m.calls
+}
+
+func TestCredentials_AsyncRefresh_WithinSoftWindowServesCacheWithoutBlocking(t *testing.T) {
+	provider := &mockExpiryProvider{
+		value: Value{AccessKeyID: "AKID", ProviderName: "mockExpiryProvider"},
+	}
+	provider.SetExpiration(time.Now().Add(4*time.Minute), 0)
+
+	c := NewCredentialsWithOptions(provider, Options{
+		RefreshBeforeExpiry: 5 * time.Minute,
+		AsyncRefresh:        true,
+	})
+
+	if _, err := c.Get(); err != nil {
+		t.Fatalf("priming Get failed: %v", err)
+	}
+	if got := provider.callCount(); got != 1 {
+		t.Fatalf("expected 1 Retrieve call after priming Get, got %d", got)
+	}
+
+	// Block any further Retrieve. If Get took the synchronous refresh
+	// path despite still being inside the soft window, it would hang
+	// here until the test times out.
+	block := make(chan struct{})
+	defer close(block)
+	provider.setRetrieveFn(func() (Value, error) {
+		<-block
+		return Value{AccessKeyID: "REFRESHED", ProviderName: "mockExpiryProvider"}, nil
+	})
+
+	done := make(chan Value, 1)
+	go func() {
+		v, err := c.Get()
+		if err != nil {
+			t.Errorf("Get failed: %v", err)
+			return
+		}
+		done <- v
+	}()
+
+	select {
+	case v := <-done:
+		if v.AccessKeyID != "AKID" {
+			t.Fatalf("expected cached value to be served, got %+v", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Get blocked on a synchronous refresh while inside the soft window")
+	}
+}
+
+func TestCredentials_AsyncRefresh_FailureDoesNotClearCachedValue(t *testing.T) {
+	provider := &mockExpiryProvider{
+		value: Value{AccessKeyID: "AKID", ProviderName: "mockExpiryProvider"},
+	}
+	provider.SetExpiration(time.Now().Add(4*time.Minute), 0)
+
+	c := NewCredentialsWithOptions(provider, Options{
+		RefreshBeforeExpiry: 5 * time.Minute,
+		AsyncRefresh:        true,
+	})
+
+	if _, err := c.Get(); err != nil {
+		t.Fatalf("priming Get failed: %v", err)
+	}
+
+	refreshed := make(chan struct{})
+	provider.setRetrieveFn(func() (Value, error) {
+		defer close(refreshed)
+		return Value{}, errors.New("refresh failed")
+	})
+
+	// Still within the soft window: this triggers the background refresh
+	// configured above to fail, without itself failing.
+	if _, err := c.Get(); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		t.Fatal("background refresh was never attempted")
+	}
+	// singleRetrieve applies its "only cache on success" check immediately
+	// after the call above returns; give it a moment to run.
+	time.Sleep(10 * time.Millisecond)
+
+	v, err := c.Get()
+	if err != nil {
+		t.Fatalf("Get after failed background refresh returned error: %v", err)
+	}
+	if v.AccessKeyID != "AKID" {
+		t.Fatalf("expected cached value to survive failed background refresh, got %+v", v)
+	}
+}
+
+func TestCredentials_AsyncRefresh_HardExpiryStillRefreshesSynchronously(t *testing.T) {
+	provider := &mockExpiryProvider{
+		value: Value{AccessKeyID: "AKID", ProviderName: "mockExpiryProvider"},
+	}
+	provider.SetExpiration(time.Now().Add(-time.Minute), 0) // already expired
+
+	c := NewCredentialsWithOptions(provider, Options{
+		RefreshBeforeExpiry: 5 * time.Minute,
+		AsyncRefresh:        true,
+	})
+
+	v, err := c.Get()
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if v.AccessKeyID != "AKID" {
+		t.Fatalf("unexpected value: %+v", v)
+	}
+	if got := provider.callCount(); got != 1 {
+		t.Fatalf("expected exactly 1 synchronous Retrieve call for hard-expired credentials, got %d", got)
+	}
+}
+
+func TestCredentials_Hooks_FireOncePerSingleflightCoalescedRefresh(t *testing.T) {
+	block := make(chan struct{})
+	var onRetrieveCalls, onCacheHitCalls, onExpireCalls int32
+
+	provider := &mockExpiryProvider{
+		value: Value{AccessKeyID: "AKID", ProviderName: "mockExpiryProvider"},
+	}
+	// Not expired by the provider, so once the cache is populated later Gets
+	// are cache hits. The cache starts out empty, so every goroutine below
+	// still races on the same initial, synchronous refresh.
+	provider.SetExpiration(time.Now().Add(time.Hour), 0)
+	provider.setRetrieveFn(func() (Value, error) {
+		<-block
+		return provider.value, nil
+	})
+
+	c := NewCredentialsWithOptions(provider, Options{
+		OnRetrieve: func(providerName string, dur time.Duration, err error) {
+			atomic.AddInt32(&onRetrieveCalls, 1)
+		},
+		OnCacheHit: func(providerName string) {
+			atomic.AddInt32(&onCacheHitCalls, 1)
+		},
+		OnExpire: func(providerName string) {
+			atomic.AddInt32(&onExpireCalls, 1)
+		},
+	})
+
+	const n = 50
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			if _, err := c.Get(); err != nil {
+				t.Errorf("Get failed: %v", err)
+			}
+		}()
+	}
+
+	// Give every goroutine a chance to land on the shared, in-flight
+	// refresh before unblocking it.
+	time.Sleep(20 * time.Millisecond)
+	close(block)
+	wg.Wait()
+
+	if got := provider.callCount(); got != 1 {
+		t.Fatalf("expected exactly 1 Retrieve call for %d goroutines racing the same refresh, got %d", n, got)
+	}
+	if got := atomic.LoadInt32(&onRetrieveCalls); got != 1 {
+		t.Fatalf("expected exactly 1 OnRetrieve call for %d goroutines racing the same refresh, got %d", n, got)
+	}
+	if got := atomic.LoadInt32(&onCacheHitCalls); got != 0 {
+		t.Fatalf("expected no OnCacheHit calls on the initial retrieval, got %d", got)
+	}
+
+	// A subsequent Get is served from the now-populated cache.
+	if _, err := c.Get(); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if got := atomic.LoadInt32(&onCacheHitCalls); got != 1 {
+		t.Fatalf("expected exactly 1 OnCacheHit call, got %d", got)
+	}
+
+	c.Expire()
+	if got := atomic.LoadInt32(&onExpireCalls); got != 1 {
+		t.Fatalf("expected exactly 1 OnExpire call, got %d", got)
+	}
+}
@@ -0,0 +1,145 @@
+// Deprecated: aws-sdk-go is deprecated. Use aws-sdk-go-v2.
+// See https://aws.amazon.com/blogs/developer/announcing-end-of-support-for-aws-sdk-for-go-v1-on-july-31-2025/.
+
+// Package stscreds provides credential Providers that retrieve credentials
+// from AWS STS.
+package stscreds
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+const (
+	// WebIdentityProviderName is the name given to the provider used to
+	// retrieve credentials via AssumeRoleWithWebIdentity.
+	WebIdentityProviderName = "WebIdentityCredentials"
+
+	// ErrCodeWebIdentity is the error code returned when the web identity
+	// token file cannot be read, or AssumeRoleWithWebIdentity fails.
+	ErrCodeWebIdentity = "WebIdentityErr"
+)
+
+// STSClient is the subset of the STS API used by WebIdentityRoleProvider
+// to exchange a web identity token for AWS credentials. It is satisfied by
+// *sts.STS, allowing callers to inject a custom or mock STS client.
+type STSClient interface {
+	AssumeRoleWithWebIdentityWithContext(aws.Context, *sts.AssumeRoleWithWebIdentityInput, ...request.Option) (*sts.AssumeRoleWithWebIdentityOutput, error)
+}
+
+// WebIdentityRoleProvider retrieves credentials by exchanging a web
+// identity token, read from a file, for role credentials via STS
+// AssumeRoleWithWebIdentity. The token file is re-read on every Retrieve
+// because projected service account tokens (for example, Kubernetes IRSA
+// and EKS Pod Identity) are rotated out from under a running process.
+type WebIdentityRoleProvider struct {
+	credentials.Expiry
+
+	client          STSClient
+	tokenFilePath   string
+	roleARN         string
+	roleSessionName string
+
+	// ExpiryWindow will reduce the effective credential expiration by this
+	// amount so callers stop using credentials slightly before they
+	// actually expire. See credentials.Expiry.SetExpiration for details.
+	ExpiryWindow time.Duration
+
+	// Duration is the requested validity duration of the assumed role
+	// session. If zero, STS applies its own default.
+	Duration time.Duration
+}
+
+// NewWebIdentityRoleProvider returns a WebIdentityRoleProvider using the
+// given STSClient to assume roleARN, presenting the web identity token
+// found at tokenFilePath. roleSessionName may be empty, in which case a
+// unique session name is generated on every Retrieve.
+func NewWebIdentityRoleProvider(client STSClient, roleARN, roleSessionName, tokenFilePath string) *WebIdentityRoleProvider {
+	return &WebIdentityRoleProvider{
+		client:          client,
+		tokenFilePath:   tokenFilePath,
+		roleARN:         roleARN,
+		roleSessionName: roleSessionName,
+	}
+}
+
+// NewWebIdentityCredentialsFromEnv returns credentials.Credentials backed
+// by a WebIdentityRoleProvider configured from the environment variables
+// Kubernetes IRSA and EKS Pod Identity inject into a pod: AWS_ROLE_ARN,
+// AWS_WEB_IDENTITY_TOKEN_FILE, and the optional AWS_ROLE_SESSION_NAME. An
+// error is returned if AWS_ROLE_ARN or AWS_WEB_IDENTITY_TOKEN_FILE are not
+// set.
+func NewWebIdentityCredentialsFromEnv(client STSClient) (*credentials.Credentials, error) {
+	roleARN := os.Getenv("AWS_ROLE_ARN")
+	if len(roleARN) == 0 {
+		return nil, awserr.New(ErrCodeWebIdentity, "AWS_ROLE_ARN environment variable is not set", nil)
+	}
+
+	tokenFilePath := os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+	if len(tokenFilePath) == 0 {
+		return nil, awserr.New(ErrCodeWebIdentity, "AWS_WEB_IDENTITY_TOKEN_FILE environment variable is not set", nil)
+	}
+
+	roleSessionName := os.Getenv("AWS_ROLE_SESSION_NAME")
+
+	return credentials.NewCredentials(
+		NewWebIdentityRoleProvider(client, roleARN, roleSessionName, tokenFilePath),
+	), nil
+}
+
+// Retrieve retrieves credentials via AssumeRoleWithWebIdentity, using a
+// background context.
+func (p *WebIdentityRoleProvider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+// RetrieveWithContext retrieves credentials via AssumeRoleWithWebIdentity,
+// reading the web identity token from disk fresh on every call.
+func (p *WebIdentityRoleProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	b, err := ioutil.ReadFile(p.tokenFilePath)
+	if err != nil {
+		return credentials.Value{}, awserr.New(ErrCodeWebIdentity, "unable to read file at "+p.tokenFilePath, err)
+	}
+
+	sessionName := p.roleSessionName
+	if len(sessionName) == 0 {
+		// Session name is required by AssumeRoleWithWebIdentity. Since one
+		// was not configured, generate one so the provider still works.
+		sessionName = strconv.FormatInt(time.Now().UTC().UnixNano(), 10)
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.roleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(string(b)),
+	}
+	if p.Duration != 0 {
+		input.DurationSeconds = aws.Int64(int64(p.Duration.Seconds()))
+	}
+
+	resp, err := p.client.AssumeRoleWithWebIdentityWithContext(ctx, input)
+	if err != nil {
+		return credentials.Value{}, awserr.New(ErrCodeWebIdentity, "failed to retrieve credentials", err)
+	}
+	if resp.Credentials == nil {
+		return credentials.Value{}, awserr.New(ErrCodeWebIdentity, fmt.Sprintf("credentials not set in response for %s", p.roleARN), nil)
+	}
+
+	p.SetExpiration(aws.TimeValue(resp.Credentials.Expiration), p.ExpiryWindow)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(resp.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(resp.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(resp.Credentials.SessionToken),
+		ProviderName:    WebIdentityProviderName,
+	}, nil
+}
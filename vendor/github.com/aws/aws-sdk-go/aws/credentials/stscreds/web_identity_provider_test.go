@@ -0,0 +1,182 @@
+// Deprecated: aws-sdk-go is deprecated. Use aws-sdk-go-v2.
+// See https://aws.amazon.com/blogs/developer/announcing-end-of-support-for-aws-sdk-for-go-v1-on-july-31-2025/.
+package stscreds
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// mockSTSClient is an STSClient that returns a canned
+// AssumeRoleWithWebIdentityOutput/error, recording the input it was
+// called with.
+type mockSTSClient struct {
+	output *sts.AssumeRoleWithWebIdentityOutput
+	err    error
+
+	calls     int
+	lastInput *sts.AssumeRoleWithWebIdentityInput
+}
+
+func (m *mockSTSClient) AssumeRoleWithWebIdentityWithContext(ctx aws.Context, input *sts.AssumeRoleWithWebIdentityInput, opts ...request.Option) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	m.calls++
+	m.lastInput = input
+	return m.output, m.err
+}
+
+func writeTokenFile(t *testing.T, contents string) string {
+	t.Helper()
+
+	f, err := ioutil.TempFile("", "web-identity-token")
+	if err != nil {
+		t.Fatalf("failed to create temp token file: %v", err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(contents); err != nil {
+		t.Fatalf("failed to write temp token file: %v", err)
+	}
+	return f.Name()
+}
+
+func TestWebIdentityRoleProvider_RetrieveWithContext_Success(t *testing.T) {
+	tokenFile := writeTokenFile(t, "the-jwt")
+	defer os.Remove(tokenFile)
+
+	expiration := time.Now().Add(time.Hour)
+	client := &mockSTSClient{
+		output: &sts.AssumeRoleWithWebIdentityOutput{
+			Credentials: &sts.Credentials{
+				AccessKeyId:     aws.String("AKID"),
+				SecretAccessKey: aws.String("SECRET"),
+				SessionToken:    aws.String("TOKEN"),
+				Expiration:      aws.Time(expiration),
+			},
+		},
+	}
+
+	p := NewWebIdentityRoleProvider(client, "arn:aws:iam::123456789012:role/test", "session", tokenFile)
+	p.ExpiryWindow = 5 * time.Minute
+
+	v, err := p.RetrieveWithContext(aws.BackgroundContext())
+	if err != nil {
+		t.Fatalf("RetrieveWithContext failed: %v", err)
+	}
+	if v.AccessKeyID != "AKID" || v.SecretAccessKey != "SECRET" || v.SessionToken != "TOKEN" {
+		t.Fatalf("unexpected value: %+v", v)
+	}
+	if v.ProviderName != WebIdentityProviderName {
+		t.Fatalf("unexpected provider name: %s", v.ProviderName)
+	}
+	if client.lastInput == nil || aws.StringValue(client.lastInput.WebIdentityToken) != "the-jwt" {
+		t.Fatalf("expected token file contents to be sent as WebIdentityToken, got %+v", client.lastInput)
+	}
+
+	wantExpiresAt := expiration.Add(-p.ExpiryWindow).Round(0)
+	if got := p.ExpiresAt(); !got.Equal(wantExpiresAt) {
+		t.Fatalf("ExpiresAt = %v, want %v (expiration minus ExpiryWindow)", got, wantExpiresAt)
+	}
+}
+
+func TestWebIdentityRoleProvider_RetrieveWithContext_NilCredentials(t *testing.T) {
+	tokenFile := writeTokenFile(t, "the-jwt")
+	defer os.Remove(tokenFile)
+
+	client := &mockSTSClient{
+		output: &sts.AssumeRoleWithWebIdentityOutput{},
+	}
+
+	p := NewWebIdentityRoleProvider(client, "arn:aws:iam::123456789012:role/test", "session", tokenFile)
+
+	_, err := p.RetrieveWithContext(aws.BackgroundContext())
+	if err == nil {
+		t.Fatal("expected an error when the STS response has no Credentials, got nil")
+	}
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expected an awserr.Error, got %T: %v", err, err)
+	}
+	if aerr.Code() != ErrCodeWebIdentity {
+		t.Fatalf("expected error code %s, got %s", ErrCodeWebIdentity, aerr.Code())
+	}
+}
+
+func TestWebIdentityRoleProvider_RetrieveWithContext_MissingTokenFile(t *testing.T) {
+	client := &mockSTSClient{}
+
+	missing := filepath.Join(os.TempDir(), "does-not-exist-web-identity-token")
+	p := NewWebIdentityRoleProvider(client, "arn:aws:iam::123456789012:role/test", "session", missing)
+
+	_, err := p.RetrieveWithContext(aws.BackgroundContext())
+	if err == nil {
+		t.Fatal("expected an error when the token file does not exist, got nil")
+	}
+	if client.calls != 0 {
+		t.Fatalf("expected STS not to be called when the token file is unreadable, got %d calls", client.calls)
+	}
+}
+
+func TestNewWebIdentityCredentialsFromEnv(t *testing.T) {
+	tokenFile := writeTokenFile(t, "the-jwt")
+	defer os.Remove(tokenFile)
+
+	envVars := []string{"AWS_ROLE_ARN", "AWS_WEB_IDENTITY_TOKEN_FILE", "AWS_ROLE_SESSION_NAME"}
+
+	cases := map[string]struct {
+		env     map[string]string
+		wantErr bool
+	}{
+		"missing role arn": {
+			env: map[string]string{
+				"AWS_WEB_IDENTITY_TOKEN_FILE": tokenFile,
+			},
+			wantErr: true,
+		},
+		"missing token file": {
+			env: map[string]string{
+				"AWS_ROLE_ARN": "arn:aws:iam::123456789012:role/test",
+			},
+			wantErr: true,
+		},
+		"complete": {
+			env: map[string]string{
+				"AWS_ROLE_ARN":                "arn:aws:iam::123456789012:role/test",
+				"AWS_WEB_IDENTITY_TOKEN_FILE": tokenFile,
+				"AWS_ROLE_SESSION_NAME":       "session",
+			},
+			wantErr: false,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			for _, k := range envVars {
+				os.Unsetenv(k)
+			}
+			for k, v := range tt.env {
+				os.Setenv(k, v)
+			}
+			defer func() {
+				for _, k := range envVars {
+					os.Unsetenv(k)
+				}
+			}()
+
+			_, err := NewWebIdentityCredentialsFromEnv(&mockSTSClient{})
+			if tt.wantErr && err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}